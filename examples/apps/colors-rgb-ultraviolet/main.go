@@ -40,6 +40,15 @@ func run() error {
 		return fmt.Errorf("failed to start: %w", err)
 	}
 
+	// Restore the terminal on an early return or a panic, so the shell stays
+	// usable. Panics are not recovered and keep their original stack trace.
+	done := false
+	defer func() {
+		if !done {
+			_ = t.Teardown()
+		}
+	}()
+
 	app := &App{
 		term:         t,
 		fpsWidget:    newFpsWidget(),
@@ -52,6 +61,8 @@ func run() error {
 		return err
 	}
 
+	done = true
+
 	if err := t.Shutdown(ctx); err != nil {
 		return fmt.Errorf("failed to shutdown: %w", err)
 	}